@@ -17,7 +17,9 @@ import (
 	"io"
 	"log"
 	"math"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
 // GetRows return all the rows in a sheet by given worksheet name (case
@@ -63,14 +65,76 @@ type Rows struct {
 	err                        error
 	curRow, totalRow, stashRow int
 	sheet                      string
+	sheetName                  string
 	rows                       []xlsxRow
 	f                          *File
 	decoder                    *xml.Decoder
+	opts                       RowsOptions
+	hyperlinks                 map[string]string
 }
 
-// Next will return true if find the next row element.
+// RowsOptions defines the options for a range-scoped Rows iterator created
+// by File.RowsRange. StartRow and EndRow are 1-based and inclusive; a zero
+// StartRow means "from the first row" and a zero EndRow means "to the last
+// row". The first call to Next() jumps straight to StartRow, so iteration
+// actually starts there instead of the caller having to step through (and
+// receive empty results for) every row below it. Columns, when non-empty,
+// restricts Columns and CellValues to the given 1-based column numbers so
+// that decoding of the other columns can be skipped.
+type RowsOptions struct {
+	StartRow int
+	EndRow   int
+	Columns  []int
+}
+
+// wantColumn returns true if col should be materialized for the current
+// options, i.e. no column filter was given or col is part of it.
+func (opts *RowsOptions) wantColumn(col int) bool {
+	if len(opts.Columns) == 0 {
+		return true
+	}
+	for _, c := range opts.Columns {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// pastLastColumn returns true once col is beyond every column Columns asks
+// for, meaning nothing later in the row can still be wanted. It always
+// returns false when no column filter was given.
+func (opts *RowsOptions) pastLastColumn(col int) bool {
+	if len(opts.Columns) == 0 {
+		return false
+	}
+	for _, c := range opts.Columns {
+		if col <= c {
+			return false
+		}
+	}
+	return true
+}
+
+// Next will return true if find the next row element. For a RowsRange
+// iterator, the first call fast-forwards straight to RowsOptions.StartRow
+// (skipping the decoding of every row below it) rather than requiring the
+// caller to iterate through rows it didn't ask for.
 func (rows *Rows) Next() bool {
+	for rows.opts.StartRow > 0 && rows.curRow < rows.opts.StartRow-1 {
+		rows.curRow++
+		if rows.stashRow >= rows.curRow {
+			continue
+		}
+		if _, err := rows.skipRow(); err != nil {
+			rows.err = err
+			return false
+		}
+	}
 	rows.curRow++
+	if rows.opts.EndRow > 0 && rows.curRow > rows.opts.EndRow {
+		return false
+	}
 	return rows.curRow <= rows.totalRow
 }
 
@@ -92,6 +156,12 @@ func (rows *Rows) Columns() ([]string, error) {
 		return columns, err
 	}
 
+	// Next() already fast-forwards curRow to StartRow, so this only guards
+	// against Columns being called without going through Next.
+	if rows.opts.StartRow > 0 && rows.curRow < rows.opts.StartRow {
+		return rows.skipRow()
+	}
+
 	d := rows.f.sharedStringsReader()
 	for {
 		token, _ := rows.decoder.Token()
@@ -116,16 +186,43 @@ func (rows *Rows) Columns() ([]string, error) {
 				}
 			}
 			if inElement == "c" {
-				colCell := xlsxC{}
-				_ = rows.decoder.DecodeElement(&colCell, &startElement)
-				cellCol, _, err = CellNameToCoordinates(colCell.R)
+				var axis string
+				for _, attr := range startElement.Attr {
+					if attr.Name.Local == "r" {
+						axis = attr.Value
+					}
+				}
+				cellCol, _, err = CellNameToCoordinates(axis)
 				if err != nil {
 					return columns, err
 				}
+				if rows.opts.pastLastColumn(cellCol) {
+					// nothing left in this row can be wanted; skip the cell
+					// without padding columns any further, so a filtered
+					// result ends at the last requested column instead of
+					// trailing off with blanks to the row's real width.
+					if err = rows.decoder.Skip(); err != nil {
+						return columns, err
+					}
+					continue
+				}
 				blank := cellCol - len(columns)
 				for i := 1; i < blank; i++ {
 					columns = append(columns, "")
 				}
+				if !rows.opts.wantColumn(cellCol) {
+					// skip decoding the cell's value/style/formula entirely;
+					// we only needed its "r" attribute to keep columns aligned
+					if err = rows.decoder.Skip(); err != nil {
+						return columns, err
+					}
+					columns = append(columns, "")
+					continue
+				}
+				colCell := xlsxC{}
+				if err = rows.decoder.DecodeElement(&colCell, &startElement); err != nil {
+					return columns, err
+				}
 				val, _ := colCell.getValueFrom(rows.f, d)
 				columns = append(columns, val)
 			}
@@ -139,6 +236,231 @@ func (rows *Rows) Columns() ([]string, error) {
 	return columns, err
 }
 
+// CellType defines the type of a cell's raw value, mirroring the "t"
+// attribute of the underlying <c> element.
+type CellType byte
+
+// Cell types defined in the spreadsheet.
+const (
+	CellTypeUnset CellType = iota
+	CellTypeNumber
+	CellTypeSharedString
+	CellTypeInlineString
+	CellTypeFormulaString
+	CellTypeBool
+	CellTypeError
+)
+
+// CellValue represents a single cell as returned by Rows.CellValues. It
+// carries the information already available off the row's XML during
+// iteration, so callers don't need a second pass through GetCellType,
+// GetCellFormula and GetCellHyperLink to recover it.
+type CellValue struct {
+	Raw       string
+	Value     string
+	Formula   string
+	StyleID   int
+	NumFmtID  int
+	Type      CellType
+	Hyperlink string
+}
+
+// cellType maps the "t" attribute of a <c> element to a CellType.
+func cellType(t string) CellType {
+	switch t {
+	case "s":
+		return CellTypeSharedString
+	case "str":
+		return CellTypeFormulaString
+	case "inlineStr":
+		return CellTypeInlineString
+	case "b":
+		return CellTypeBool
+	case "e":
+		return CellTypeError
+	case "":
+		return CellTypeNumber
+	default:
+		return CellTypeUnset
+	}
+}
+
+// numFmtID resolves a cell style index to its number format ID, the lookup
+// through styles.xml a CellValues caller would otherwise need a second pass
+// to perform themselves.
+func (f *File) numFmtID(styleID int) int {
+	styles := f.stylesReader()
+	if styles == nil || styles.CellXfs == nil || styleID < 0 || styleID >= len(styles.CellXfs.Xf) {
+		return 0
+	}
+	return styles.CellXfs.Xf[styleID].NumFmtID
+}
+
+// loadHyperlinks resolves every hyperlink declared on the sheet once into a
+// map keyed by cell reference, so CellValues can look one up per cell in
+// O(1) instead of re-running GetCellHyperLink's full worksheet scan for
+// every wanted cell.
+func (rows *Rows) loadHyperlinks() error {
+	if rows.hyperlinks != nil {
+		return nil
+	}
+	rows.hyperlinks = map[string]string{}
+	xlsx, err := rows.f.workSheetReader(rows.sheetName)
+	if err != nil {
+		return err
+	}
+	if xlsx.Hyperlinks == nil {
+		return nil
+	}
+	for _, link := range xlsx.Hyperlinks.Hyperlink {
+		if link.Ref == "" {
+			continue
+		}
+		if _, target, err := rows.f.GetCellHyperLink(rows.sheetName, link.Ref); err == nil {
+			rows.hyperlinks[link.Ref] = target
+		}
+	}
+	return nil
+}
+
+// CellValues works like Columns but returns each column as a CellValue
+// instead of a plain string, exposing the raw value, resolved value,
+// formula, style ID, resolved number format ID, cell type and hyperlink
+// target (if any) of every cell in the current row.
+func (rows *Rows) CellValues() ([]CellValue, error) {
+	var (
+		err          error
+		inElement    string
+		row, cellCol int
+		columns      []CellValue
+	)
+
+	if rows.stashRow >= rows.curRow {
+		return columns, err
+	}
+
+	// Next() already fast-forwards curRow to StartRow, so this only guards
+	// against CellValues being called without going through Next.
+	if rows.opts.StartRow > 0 && rows.curRow < rows.opts.StartRow {
+		_, err = rows.skipRow()
+		return columns, err
+	}
+
+	if err = rows.loadHyperlinks(); err != nil {
+		return columns, err
+	}
+
+	d := rows.f.sharedStringsReader()
+	for {
+		token, _ := rows.decoder.Token()
+		if token == nil {
+			break
+		}
+		switch startElement := token.(type) {
+		case xml.StartElement:
+			inElement = startElement.Name.Local
+			if inElement == "row" {
+				for _, attr := range startElement.Attr {
+					if attr.Name.Local == "r" {
+						row, err = strconv.Atoi(attr.Value)
+						if err != nil {
+							return columns, err
+						}
+						if row > rows.curRow {
+							rows.stashRow = row - 1
+							return columns, err
+						}
+					}
+				}
+			}
+			if inElement == "c" {
+				var axis string
+				for _, attr := range startElement.Attr {
+					if attr.Name.Local == "r" {
+						axis = attr.Value
+					}
+				}
+				cellCol, _, err = CellNameToCoordinates(axis)
+				if err != nil {
+					return columns, err
+				}
+				if rows.opts.pastLastColumn(cellCol) {
+					if err = rows.decoder.Skip(); err != nil {
+						return columns, err
+					}
+					continue
+				}
+				blank := cellCol - len(columns)
+				for i := 1; i < blank; i++ {
+					columns = append(columns, CellValue{})
+				}
+				if !rows.opts.wantColumn(cellCol) {
+					// an unwanted column is left as the zero CellValue
+					// entirely, the same way Columns leaves it as "" --
+					// nothing is decoded or resolved for it.
+					if err = rows.decoder.Skip(); err != nil {
+						return columns, err
+					}
+					columns = append(columns, CellValue{})
+					continue
+				}
+				colCell := xlsxC{}
+				if err = rows.decoder.DecodeElement(&colCell, &startElement); err != nil {
+					return columns, err
+				}
+				cv := CellValue{
+					Raw:       colCell.V,
+					StyleID:   colCell.S,
+					NumFmtID:  rows.f.numFmtID(colCell.S),
+					Type:      cellType(colCell.T),
+					Hyperlink: rows.hyperlinks[colCell.R],
+				}
+				cv.Value, _ = colCell.getValueFrom(rows.f, d)
+				if colCell.F != nil {
+					cv.Formula = colCell.F.Content
+				}
+				columns = append(columns, cv)
+			}
+		case xml.EndElement:
+			inElement = startElement.Name.Local
+			if inElement == "row" {
+				return columns, err
+			}
+		}
+	}
+	return columns, err
+}
+
+// skipRow advances the decoder past the next row element without decoding
+// its cells. If that row's number is past curRow, it stops right after the
+// row's start tag and records the gap in stashRow instead. Next uses it to
+// fast-forward through the rows before RowsOptions.StartRow; Columns and
+// CellValues use it only when called before Next has reached StartRow.
+func (rows *Rows) skipRow() ([]string, error) {
+	for {
+		token, _ := rows.decoder.Token()
+		if token == nil {
+			return nil, nil
+		}
+		startElement, ok := token.(xml.StartElement)
+		if !ok || startElement.Name.Local != "row" {
+			continue
+		}
+		for _, attr := range startElement.Attr {
+			if attr.Name.Local == "r" {
+				if r, err := strconv.Atoi(attr.Value); err == nil && r > rows.curRow {
+					rows.stashRow = r - 1
+					return nil, nil
+				}
+			}
+		}
+		if err := rows.decoder.Skip(); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+}
+
 // ErrSheetNotExist defines an error of sheet is not exist
 type ErrSheetNotExist struct {
 	SheetName string
@@ -167,6 +489,40 @@ func (err ErrSheetNotExist) Error() string {
 //    }
 //
 func (f *File) Rows(sheet string) (*Rows, error) {
+	return f.newRows(sheet, RowsOptions{})
+}
+
+// RowsRange returns a rows iterator scoped to the given RowsOptions, letting
+// callers skip decoding of rows and columns they don't need when only a
+// window of a large sheet is of interest. For example, to scan rows 100
+// through 200 and materialize only columns A and C:
+//
+//    rows, err := f.RowsRange("Sheet1", excelize.RowsOptions{
+//        StartRow: 100,
+//        EndRow:   200,
+//        Columns:  []int{1, 3},
+//    })
+//    if err != nil {
+//        fmt.Println(err)
+//        return
+//    }
+//    for rows.Next() {
+//        row, err := rows.Columns()
+//        if err != nil {
+//            fmt.Println(err)
+//        }
+//        for _, colCell := range row {
+//            fmt.Print(colCell, "\t")
+//        }
+//        fmt.Println()
+//    }
+//
+func (f *File) RowsRange(sheet string, opts RowsOptions) (*Rows, error) {
+	return f.newRows(sheet, opts)
+}
+
+// newRows builds the Rows iterator shared by Rows and RowsRange.
+func (f *File) newRows(sheet string, opts RowsOptions) (*Rows, error) {
 	name, ok := f.sheetMap[trimSheetName(sheet)]
 	if !ok {
 		return nil, ErrSheetNotExist{sheet}
@@ -207,6 +563,8 @@ func (f *File) Rows(sheet string) (*Rows, error) {
 	}
 	rows.f = f
 	rows.sheet = name
+	rows.sheetName = sheet
+	rows.opts = opts
 	rows.decoder = f.xmlNewDecoder(bytes.NewReader(f.readXML(name)))
 	return &rows, nil
 }
@@ -434,6 +792,47 @@ func (f *File) RemoveRow(sheet string, row int) error {
 	return nil
 }
 
+// RemoveRows provides a function to remove count rows starting at the given
+// Excel row number in a single pass. For example, remove rows 3 through 5
+// (inclusive) in Sheet1:
+//
+//    err := f.RemoveRows("Sheet1", 3, 3)
+//
+// Unlike calling RemoveRow count times, row and cell references (via
+// adjustHelper), merged cell ranges, the sheet's autofilter range, data
+// validations, conditional formatting ranges and defined names that refer to
+// the sheet are each shifted once, rather than once per removed row. Chart series
+// references live outside the worksheet part and are not adjusted by this
+// function — the same limitation RemoveRow has.
+//
+// Use this method with caution, which will affect changes in references
+// such as formulas, charts, and so on. If there is any referenced value of
+// the worksheet, it will cause a file error when you open it. The excelize
+// only partially updates these references currently.
+func (f *File) RemoveRows(sheet string, row, count int) error {
+	if row < 1 {
+		return newInvalidRowNumberError(row)
+	}
+	if count < 1 {
+		return nil
+	}
+
+	xlsx, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	last := row + count - 1
+	kept := xlsx.SheetData.Row[:0]
+	for _, r := range xlsx.SheetData.Row {
+		if r.R < row || r.R > last {
+			kept = append(kept, r)
+		}
+	}
+	xlsx.SheetData.Row = kept
+	f.shiftSheetReferences(sheet, xlsx, row, -count)
+	return f.adjustHelper(sheet, rows, row, -count)
+}
+
 // InsertRow provides a function to insert a new row after given Excel row
 // number starting from 1. For example, create a new row before row 3 in
 // Sheet1:
@@ -451,6 +850,100 @@ func (f *File) InsertRow(sheet string, row int) error {
 	return f.adjustHelper(sheet, rows, row, 1)
 }
 
+// InsertRows provides a function to insert count new rows before given
+// Excel row number in a single pass. For example, create 3 new rows before
+// row 3 in Sheet1:
+//
+//    err := f.InsertRows("Sheet1", 3, 3)
+//
+// Unlike calling InsertRow count times, row and cell references (via
+// adjustHelper), merged cell ranges, the sheet's autofilter range, data
+// validations, conditional formatting ranges and defined names that refer to
+// the sheet are each shifted once, rather than once per inserted row. Chart series
+// references live outside the worksheet part and are not adjusted by this
+// function — the same limitation InsertRow has.
+//
+// Use this method with caution, which will affect changes in references
+// such as formulas, charts, and so on. If there is any referenced value of
+// the worksheet, it will cause a file error when you open it. The excelize
+// only partially updates these references currently.
+func (f *File) InsertRows(sheet string, row, count int) error {
+	if row < 1 {
+		return newInvalidRowNumberError(row)
+	}
+	if count < 1 {
+		return nil
+	}
+	xlsx, err := f.workSheetReader(sheet)
+	if err != nil {
+		return err
+	}
+	f.shiftSheetReferences(sheet, xlsx, row, count)
+	return f.adjustHelper(sheet, rows, row, count)
+}
+
+// cellRefRegexp matches a single A1-style cell reference (with optional
+// absolute-reference dollar signs), the building block of every range this
+// package shifts row numbers within.
+var cellRefRegexp = regexp.MustCompile(`(\$?[A-Z]{1,3})(\$?)([0-9]+)`)
+
+// shiftRowRefs rewrites every cell reference in an A1-style range or list of
+// ranges whose row is >= row, adding offset to it. Column letters and dollar
+// signs are left untouched. Sheet qualifiers are not recognised, so the text
+// passed in must not contain one: a sheet name such as "Q1" would be shifted
+// like a cell reference.
+func shiftRowRefs(s string, row, offset int) string {
+	return cellRefRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		m := cellRefRegexp.FindStringSubmatch(ref)
+		col, dollar, rowPart := m[1], m[2], m[3]
+		n, err := strconv.Atoi(rowPart)
+		if err != nil || n < row {
+			return ref
+		}
+		if n += offset; n < 1 {
+			n = 1
+		}
+		return col + dollar + strconv.Itoa(n)
+	})
+}
+
+// shiftSheetReferences shifts, in the same single pass InsertRows/RemoveRows
+// already make over SheetData.Row, the sheet-scoped references adjustHelper
+// does not cover on its own: the sheet's autofilter range, data validation
+// and conditional formatting sqrefs, and any defined name whose reference
+// begins with this sheet's name followed by "!" (the name's own scope is not
+// looked at). Merged cells, formulas and cell/row positions are shifted by
+// adjustHelper itself, exactly as they are for the single-row
+// InsertRow/RemoveRow. Chart series references live in a separate XML part
+// (xl/charts/chartN.xml) outside of xlsxWorksheet and are not touched here.
+func (f *File) shiftSheetReferences(sheet string, xlsx *xlsxWorksheet, row, offset int) {
+	if f.WorkBook != nil && f.WorkBook.DefinedNames != nil {
+		prefix := sheet + "!"
+		for i, dn := range f.WorkBook.DefinedNames.DefinedName {
+			if strings.HasPrefix(dn.Data, prefix) {
+				// shift only what follows each "sheet!" qualifier, so the
+				// sheet name itself is never read as a cell reference
+				parts := strings.Split(dn.Data, prefix)
+				for j := range parts {
+					parts[j] = shiftRowRefs(parts[j], row, offset)
+				}
+				f.WorkBook.DefinedNames.DefinedName[i].Data = strings.Join(parts, prefix)
+			}
+		}
+	}
+	if xlsx.AutoFilter != nil {
+		xlsx.AutoFilter.Ref = shiftRowRefs(xlsx.AutoFilter.Ref, row, offset)
+	}
+	if xlsx.DataValidations != nil {
+		for i, dv := range xlsx.DataValidations.DataValidation {
+			xlsx.DataValidations.DataValidation[i].Sqref = shiftRowRefs(dv.Sqref, row, offset)
+		}
+	}
+	for i, cf := range xlsx.ConditionalFormatting {
+		xlsx.ConditionalFormatting[i].SQRef = shiftRowRefs(cf.SQRef, row, offset)
+	}
+}
+
 // DuplicateRow inserts a copy of specified row (by its Excel row number) below
 //
 //    err := f.DuplicateRow("Sheet1", 2)
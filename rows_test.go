@@ -0,0 +1,121 @@
+// Copyright 2016 - 2020 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowsRange(t *testing.T) {
+	f := NewFile()
+	for r := 1; r <= 10; r++ {
+		for c := 1; c <= 5; c++ {
+			cell, err := CoordinatesToCellName(c, r)
+			assert.NoError(t, err)
+			assert.NoError(t, f.SetCellValue("Sheet1", cell, r*100+c))
+		}
+	}
+
+	rows, err := f.RowsRange("Sheet1", RowsOptions{StartRow: 3, EndRow: 5, Columns: []int{1, 3}})
+	assert.NoError(t, err)
+
+	var got [][]string
+	for rows.Next() {
+		row, err := rows.Columns()
+		assert.NoError(t, err)
+		got = append(got, row)
+	}
+	assert.NoError(t, rows.Error())
+	assert.Equal(t, [][]string{
+		{"301", "", "303"},
+		{"401", "", "403"},
+		{"501", "", "503"},
+	}, got)
+
+	_, err = f.RowsRange("SheetN", RowsOptions{})
+	assert.EqualError(t, err, "sheet SheetN is not exist")
+}
+
+func TestRowsRangeDefaultsMatchRows(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", "Excel"))
+
+	rows, err := f.RowsRange("Sheet1", RowsOptions{})
+	assert.NoError(t, err)
+	assert.True(t, rows.Next())
+	row, err := rows.Columns()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Excel"}, row)
+	assert.False(t, rows.Next())
+}
+
+func TestRowsCellValues(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellValue("Sheet1", "A1", "Excel"))
+	assert.NoError(t, f.SetCellFormula("Sheet1", "B1", "1+1"))
+	assert.NoError(t, f.SetCellHyperLink("Sheet1", "C1", "https://example.com", "External"))
+	assert.NoError(t, f.SetCellValue("Sheet1", "C1", "link"))
+
+	rows, err := f.Rows("Sheet1")
+	assert.NoError(t, err)
+	assert.True(t, rows.Next())
+	values, err := rows.CellValues()
+	assert.NoError(t, err)
+	assert.Len(t, values, 3)
+	assert.Equal(t, "Excel", values[0].Value)
+	assert.Equal(t, CellTypeSharedString, values[0].Type)
+	assert.Equal(t, "1+1", values[1].Formula)
+	assert.Equal(t, "https://example.com", values[2].Hyperlink)
+	assert.False(t, rows.Next())
+}
+
+func TestInsertRowsRemoveRows(t *testing.T) {
+	f := NewFile()
+	for r := 1; r <= 5; r++ {
+		assert.NoError(t, f.SetCellValue("Sheet1", "A"+strconv.Itoa(r), r))
+	}
+	assert.NoError(t, f.MergeCell("Sheet1", "B2", "B3"))
+
+	assert.NoError(t, f.InsertRows("Sheet1", 2, 2))
+	v, err := f.GetCellValue("Sheet1", "A5")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", v)
+	xlsx, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B4:B5", xlsx.MergeCells.Cells[0].Ref)
+
+	assert.NoError(t, f.RemoveRows("Sheet1", 2, 2))
+	v, err = f.GetCellValue("Sheet1", "A3")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", v)
+	xlsx, err = f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "B2:B3", xlsx.MergeCells.Cells[0].Ref)
+
+	assert.Error(t, f.InsertRows("Sheet1", 0, 1))
+	assert.Error(t, f.RemoveRows("Sheet1", 0, 1))
+	assert.NoError(t, f.InsertRows("Sheet1", 1, 0))
+	assert.NoError(t, f.RemoveRows("Sheet1", 1, 0))
+}
+
+func TestInsertRowsRemoveRowsShiftsAutoFilter(t *testing.T) {
+	f := NewFile()
+	xlsx, err := f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	xlsx.AutoFilter = &xlsxAutoFilter{Ref: "A2:A2"}
+
+	assert.NoError(t, f.InsertRows("Sheet1", 1, 2))
+	xlsx, err = f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A4:A4", xlsx.AutoFilter.Ref)
+
+	assert.NoError(t, f.RemoveRows("Sheet1", 1, 2))
+	xlsx, err = f.workSheetReader("Sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "A2:A2", xlsx.AutoFilter.Ref)
+}
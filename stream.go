@@ -0,0 +1,323 @@
+// Copyright 2016 - 2020 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to
+// and read from XLSX file. Support reads and writes XLSX file generated by
+// Microsoft Excel™ 2007 and later. Support save file without losing original
+// charts of XLSX. This library needs Go version 1.10 or later.
+
+package excelize
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+)
+
+// StreamWriter defines the structure used by stream writer.
+type StreamWriter struct {
+	File    *File
+	Sheet   string
+	SheetID int
+	rawData bufferedWriter
+	rows    int
+	// sharedStrings caches the index each string value was written at, so
+	// that a value repeated across SetRow calls reuses its shared string
+	// entry instead of growing xl/sharedStrings.xml on every occurrence.
+	sharedStrings map[string]int
+}
+
+// bufferedWriter wraps a temporary on-disk file so that streamed rows never
+// have to be held in memory as part of xlsxWorksheet.SheetData.Row.
+type bufferedWriter struct {
+	tmp *os.File
+	buf *bufio.Writer
+}
+
+// Cell can be used directly in StreamWriter.SetRow to specify a style and
+// force a cell to be a formula.
+type Cell struct {
+	StyleID int
+	Formula string
+	Value   interface{}
+}
+
+// NewStreamWriter returns stream writer struct by given worksheet name for
+// generating the new worksheet with large amounts of data. Note that after
+// set rows, you must call the Flush method to end the streaming writing
+// process, and that rows must be set in order, starting from row 1 with no
+// row skipped. A
+// StreamWriter is not safe for concurrent use by multiple goroutines, and
+// two StreamWriters must not be used concurrently on the same *File since
+// both mutate the shared workbook's sheet data and shared strings table.
+// For example:
+//
+//    file := excelize.NewFile()
+//    streamWriter, err := file.NewStreamWriter("Sheet1")
+//    if err != nil {
+//        fmt.Println(err)
+//    }
+//    for rowID := 1; rowID <= 1000000; rowID++ {
+//        row := make([]interface{}, 10)
+//        for colID := 0; colID < 10; colID++ {
+//            row[colID] = rowID * colID
+//        }
+//        cell, _ := excelize.CoordinatesToCellName(1, rowID)
+//        if err := streamWriter.SetRow(cell, row); err != nil {
+//            fmt.Println(err)
+//        }
+//    }
+//    if err := streamWriter.Flush(); err != nil {
+//        fmt.Println(err)
+//    }
+//    if err := file.SaveAs("Book1.xlsx"); err != nil {
+//        fmt.Println(err)
+//    }
+//
+func (f *File) NewStreamWriter(sheet string) (*StreamWriter, error) {
+	name, ok := f.sheetMap[trimSheetName(sheet)]
+	if !ok {
+		return nil, ErrSheetNotExist{sheet}
+	}
+	sw := &StreamWriter{
+		File:    f,
+		Sheet:   sheet,
+		SheetID: f.getSheetID(sheet),
+	}
+	tmp, err := ioutil.TempFile(os.TempDir(), "excelize-stream")
+	if err != nil {
+		return nil, err
+	}
+	sw.rawData.tmp = tmp
+	sw.rawData.buf = bufio.NewWriter(sw.rawData.tmp)
+	// Discard the in-memory rows that may already exist so that a reopened
+	// file can still be streamed into from scratch.
+	if f.Sheet[name] != nil {
+		f.Sheet[name].SheetData.Row = nil
+	}
+	if _, err := sw.rawData.buf.WriteString(`<sheetData>`); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// SetRow writes an array to stream rows by giving a starting coordinate and
+// a slice of values. Note that you must call the 'Flush' method after the
+// stream writer has been used.
+//
+// As a special case, if Cell is used as a value, then the Cell.StyleID will
+// be applied to that cell and Cell.Formula, if set, is written as the cell's
+// formula, with Cell.Value still written as the cell's value.
+func (sw *StreamWriter) SetRow(cell string, values []interface{}) error {
+	col, row, err := CellNameToCoordinates(cell)
+	if err != nil {
+		return err
+	}
+	if col != 1 {
+		return fmt.Errorf("the column number of the start cell must be 1")
+	}
+	sw.rows++
+	if row != sw.rows {
+		return fmt.Errorf("invalid row number, must be continuous and start from 1")
+	}
+	fmt.Fprintf(sw.rawData.buf, `<row r="%d">`, row)
+	for i, val := range values {
+		axis, err := CoordinatesToCellName(col+i, row)
+		if err != nil {
+			return err
+		}
+		if err := sw.writeCell(axis, val); err != nil {
+			return err
+		}
+	}
+	_, err = sw.rawData.buf.WriteString(`</row>`)
+	return err
+}
+
+// writeCell marshals a single cell value, adding string values to the
+// workbook's shared strings table (rather than writing them inline) so the
+// sheet stays append-only and repeated values stay cheap to store. The table
+// is written out as xl/sharedStrings.xml by Flush.
+func (sw *StreamWriter) writeCell(axis string, val interface{}) error {
+	c := xlsxC{R: axis}
+	if cell, ok := val.(Cell); ok {
+		c.S = cell.StyleID
+		if cell.Formula != "" {
+			c.F = &xlsxF{Content: cell.Formula}
+		}
+		val = cell.Value
+	}
+	switch v := val.(type) {
+	case nil:
+		// leave an empty cell so column alignment is preserved
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		c.V = fmt.Sprint(v)
+	case bool:
+		c.T = "b"
+		c.V = "0"
+		if v {
+			c.V = "1"
+		}
+	case string:
+		c.T = "s"
+		c.V = strconv.Itoa(sw.sharedStringIndex(v))
+	default:
+		c.T = "s"
+		c.V = strconv.Itoa(sw.sharedStringIndex(fmt.Sprint(v)))
+	}
+	output, err := xml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	_, err = sw.rawData.buf.Write(output)
+	return err
+}
+
+// sharedStringIndex appends s to the shared strings table if it hasn't
+// already been added by this stream writer, and returns its index either way.
+// Count tracks every reference to a shared string, unique or not, while
+// UniqueCount and the SI table only grow on the first occurrence.
+func (sw *StreamWriter) sharedStringIndex(s string) int {
+	sst := sw.File.sharedStringsReader()
+	sst.Count++
+	if idx, ok := sw.sharedStrings[s]; ok {
+		return idx
+	}
+	if sw.sharedStrings == nil {
+		sw.sharedStrings = make(map[string]int)
+	}
+	idx := len(sst.SI)
+	sst.SI = append(sst.SI, xlsxSI{T: s})
+	sst.UniqueCount++
+	sw.sharedStrings[s] = idx
+	return idx
+}
+
+// Flush ends the streaming writing process, merges the temporary on-disk
+// sheet data produced by SetRow back into the archive, and must be called
+// after the last call to SetRow.
+func (sw *StreamWriter) Flush() error {
+	if _, err := sw.rawData.buf.WriteString(`</sheetData>`); err != nil {
+		return err
+	}
+	if err := sw.rawData.buf.Flush(); err != nil {
+		return err
+	}
+	name, ok := sw.File.sheetMap[trimSheetName(sw.Sheet)]
+	if !ok {
+		return ErrSheetNotExist{sw.Sheet}
+	}
+	if _, err := sw.rawData.tmp.Seek(0, 0); err != nil {
+		return err
+	}
+	sheetData, err := ioutil.ReadAll(sw.rawData.tmp)
+	if err != nil {
+		return err
+	}
+	if err := sw.rawData.tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Remove(sw.rawData.tmp.Name()); err != nil {
+		return err
+	}
+	before, after := splitSheetData(sw.File.readXML(name))
+	sw.File.Sheet[name] = nil
+	sw.File.saveFileList(name, append(append(before, sheetData...), after...))
+	if len(sw.sharedStrings) > 0 {
+		output, err := xml.Marshal(sw.File.SharedStrings)
+		if err != nil {
+			return err
+		}
+		sw.File.registerSharedStrings()
+		sw.File.saveFileList("xl/sharedStrings.xml", append([]byte(xml.Header), output...))
+	}
+	return nil
+}
+
+// sharedStringsContentType and sharedStringsRelType identify the shared
+// strings part the same way Excel's own writer does, for
+// [Content_Types].xml and xl/_rels/workbook.xml.rels respectively.
+const (
+	sharedStringsContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"
+	sharedStringsRelType     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings"
+	sharedStringsPartName    = "/xl/sharedStrings.xml"
+)
+
+// registerSharedStrings declares xl/sharedStrings.xml in [Content_Types].xml
+// and links it from the workbook relationships if it isn't already present.
+// A brand new *File has no shared strings part until something needs one, so
+// streaming the first string value must add both, or the part excelize just
+// wrote is invisible to the rest of the package and to Excel itself.
+func (f *File) registerSharedStrings() {
+	if f.ContentTypes != nil {
+		hasOverride := false
+		for _, o := range f.ContentTypes.Overrides {
+			if o.PartName == sharedStringsPartName {
+				hasOverride = true
+				break
+			}
+		}
+		if !hasOverride {
+			f.ContentTypes.Overrides = append(f.ContentTypes.Overrides, xlsxOverride{
+				PartName:    sharedStringsPartName,
+				ContentType: sharedStringsContentType,
+			})
+		}
+	}
+	if f.WorkBookRels != nil {
+		for _, r := range f.WorkBookRels.Relationships {
+			if r.Type == sharedStringsRelType {
+				return
+			}
+		}
+		f.WorkBookRels.Relationships = append(f.WorkBookRels.Relationships, xlsxRelationship{
+			ID:     "rId" + strconv.Itoa(len(f.WorkBookRels.Relationships)+1),
+			Type:   sharedStringsRelType,
+			Target: "sharedStrings.xml",
+		})
+	}
+}
+
+// splitSheetData returns the worksheet XML with its sheetData element
+// removed, split into the bytes that precede and follow it, so the streamed
+// sheet data can be spliced back in without re-serializing the rest of the
+// worksheet. It handles both the "<sheetData>...</sheetData>" form and the
+// self-closing "<sheetData/>" form Excel writes for a sheet with no rows.
+func splitSheetData(content []byte) (before, after []byte) {
+	const openTag = "<sheetData"
+	openIdx := indexOf(content, openTag)
+	if openIdx == -1 {
+		return content, nil
+	}
+	tagEnd := indexOfFrom(content, ">", openIdx)
+	if tagEnd == -1 {
+		return content, nil
+	}
+	if content[tagEnd-1] == '/' {
+		// self-closing: "<sheetData/>", nothing to keep between the tags.
+		return content[:openIdx], content[tagEnd+1:]
+	}
+	const closeTag = "</sheetData>"
+	closeIdx := indexOfFrom(content, closeTag, tagEnd)
+	if closeIdx == -1 {
+		return content, nil
+	}
+	return content[:openIdx], content[closeIdx+len(closeTag):]
+}
+
+func indexOf(content []byte, sub string) int {
+	return indexOfFrom(content, sub, 0)
+}
+
+func indexOfFrom(content []byte, sub string, from int) int {
+	for i := from; i+len(sub) <= len(content); i++ {
+		if string(content[i:i+len(sub)]) == sub {
+			return i
+		}
+	}
+	return -1
+}
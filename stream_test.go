@@ -0,0 +1,55 @@
+// Copyright 2016 - 2020 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamWriter(t *testing.T) {
+	f := NewFile()
+	sw, err := f.NewStreamWriter("Sheet1")
+	assert.NoError(t, err)
+
+	for r := 1; r <= 5; r++ {
+		cell, err := CoordinatesToCellName(1, r)
+		assert.NoError(t, err)
+		assert.NoError(t, sw.SetRow(cell, []interface{}{r, "val", true}))
+	}
+	assert.NoError(t, sw.Flush())
+
+	for r := 1; r <= 5; r++ {
+		v, err := f.GetCellValue("Sheet1", "A"+strconv.Itoa(r))
+		assert.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(r), v)
+
+		v, err = f.GetCellValue("Sheet1", "B"+strconv.Itoa(r))
+		assert.NoError(t, err)
+		assert.Equal(t, "val", v)
+	}
+
+	rows, err := f.Rows("Sheet1")
+	assert.NoError(t, err)
+	assert.True(t, rows.Next())
+	values, err := rows.CellValues()
+	assert.NoError(t, err)
+	assert.Len(t, values, 3)
+	assert.Equal(t, CellTypeSharedString, values[1].Type)
+
+	_, err = f.NewStreamWriter("SheetN")
+	assert.EqualError(t, err, "sheet SheetN is not exist")
+}
+
+func TestStreamWriterSetRowErrors(t *testing.T) {
+	f := NewFile()
+	sw, err := f.NewStreamWriter("Sheet1")
+	assert.NoError(t, err)
+
+	assert.Error(t, sw.SetRow("B1", []interface{}{1}))
+	assert.Error(t, sw.SetRow("A2", []interface{}{1}))
+}